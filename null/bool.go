@@ -0,0 +1,194 @@
+// Package null mirrors the zero package, but treats only an explicit JSON
+// or SQL null as null. Unlike zero, the Go zero value (false, 0, "", ...)
+// round-trips faithfully: it is a perfectly valid, non-null value.
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Bool is a nullable bool. Only an explicit JSON/SQL null is null.
+type Bool struct {
+	sql.NullBool
+}
+
+// NewBool creates a new Bool
+func NewBool(b bool, valid bool) Bool {
+	return Bool{
+		NullBool: sql.NullBool{
+			Bool:  b,
+			Valid: valid,
+		},
+	}
+}
+
+// BoolFrom creates a new valid Bool.
+func BoolFrom(b bool) Bool {
+	return NewBool(b, true)
+}
+
+// BoolFromPtr creates a new Bool that will be null if b is nil.
+func BoolFromPtr(b *bool) Bool {
+	if b == nil {
+		return NewBool(false, false)
+	}
+	return NewBool(*b, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It only sets Valid=false for a JSON null; "false" unmarshals to a valid
+// false Bool.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var err error
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case bool:
+		b.Bool = x
+		b.Valid = true
+	case map[string]interface{}:
+		err = json.Unmarshal(data, &b.NullBool)
+	case nil:
+		b.Valid = false
+		return nil
+	default:
+		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Bool", reflect.TypeOf(v).Name())
+	}
+	if err != nil {
+		b.Valid = false
+	}
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Bool if the input is blank or "null".
+func (b *Bool) UnmarshalText(text []byte) error {
+	str := string(text)
+	switch str {
+	case "", "null":
+		b.Valid = false
+		return nil
+	case "true":
+		b.Bool = true
+	case "false":
+		b.Bool = false
+	default:
+		b.Valid = false
+		return errors.New("invalid input:" + str)
+	}
+	b.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	if b.Bool {
+		return []byte("true"), nil
+	}
+	return []byte("false"), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this Bool is null.
+func (b Bool) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	if b.Bool {
+		return []byte("true"), nil
+	}
+	return []byte("false"), nil
+}
+
+// SetValid changes this Bool's value and also sets it to be non-null.
+func (b *Bool) SetValid(v bool) {
+	b.Bool = v
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Bool's value, or a nil pointer if this Bool is null.
+func (b Bool) Ptr() *bool {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bool
+}
+
+// IsZero returns true for null Bools, for future omitempty support. Unlike
+// zero.Bool, a valid false is not considered zero.
+func (b Bool) IsZero() bool {
+	return !b.Valid
+}
+
+// IsUnknown returns true if this Bool represents SQL UNKNOWN, i.e. it is null.
+func (b Bool) IsUnknown() bool {
+	return !b.Valid
+}
+
+// OverwriteWithIfValid sets this Bool's value to v if valid is true. Used
+// for type conversion from sql.NullBool to null.
+func (b *Bool) OverwriteWithIfValid(v bool, valid bool) {
+	if valid {
+		b.Bool = v
+		b.Valid = valid
+	}
+}
+
+// Boolean operators implementing SQL's three-valued (Kleene) logic: a Bool
+// with Valid=false stands for UNKNOWN, and UNKNOWN propagates the same way
+// NULL does in a SQL WHERE clause. See zero.Bool for the identical rules.
+
+// AND operation.
+func (s Bool) AND(other Bool) Bool {
+	if s.Valid && !s.Bool {
+		return NewBool(false, true)
+	}
+	if other.Valid && !other.Bool {
+		return NewBool(false, true)
+	}
+	if !s.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return NewBool(s.Bool && other.Bool, true)
+}
+
+// OR operation.
+func (s Bool) OR(other Bool) Bool {
+	if s.Valid && s.Bool {
+		return NewBool(true, true)
+	}
+	if other.Valid && other.Bool {
+		return NewBool(true, true)
+	}
+	if !s.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return NewBool(s.Bool || other.Bool, true)
+}
+
+// NON operation.
+// Leaves s unchanged (UNKNOWN) if s is UNKNOWN.
+func (s *Bool) NON() {
+	if s.Valid {
+		s.Bool = !s.Bool
+	}
+}
+
+// XOR operation.
+// Returns UNKNOWN (Valid=false) whenever either operand is UNKNOWN.
+func (s Bool) XOR(other Bool) Bool {
+	if !s.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return NewBool(s.Bool != other.Bool, true)
+}
@@ -0,0 +1,64 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBoolJSONFalseIsValid(t *testing.T) {
+	var b Bool
+	if err := json.Unmarshal([]byte("false"), &b); err != nil {
+		t.Fatal(err)
+	}
+	if !b.Valid || b.Bool {
+		t.Errorf("got %+v, want valid false", b)
+	}
+
+	out, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "false" {
+		t.Errorf("MarshalJSON() = %s, want false", out)
+	}
+}
+
+func TestBoolJSONNull(t *testing.T) {
+	var b Bool
+	if err := json.Unmarshal([]byte("null"), &b); err != nil {
+		t.Fatal(err)
+	}
+	if b.Valid {
+		t.Error("expected JSON null to unmarshal as invalid")
+	}
+
+	out, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "null" {
+		t.Errorf("MarshalJSON() = %s, want null", out)
+	}
+}
+
+func TestBoolKleeneLogic(t *testing.T) {
+	tTrue := BoolFrom(true)
+	tFalse := BoolFrom(false)
+	unknown := NewBool(false, false)
+
+	if got := tFalse.AND(unknown); got.Valid != true || got.Bool != false {
+		t.Errorf("false AND UNKNOWN = %+v, want valid false", got)
+	}
+	if got := tTrue.AND(unknown); got.Valid {
+		t.Errorf("true AND UNKNOWN = %+v, want UNKNOWN", got)
+	}
+	if got := tTrue.OR(unknown); got.Valid != true || got.Bool != true {
+		t.Errorf("true OR UNKNOWN = %+v, want valid true", got)
+	}
+	if got := tFalse.OR(unknown); got.Valid {
+		t.Errorf("false OR UNKNOWN = %+v, want UNKNOWN", got)
+	}
+	if got := tTrue.XOR(unknown); got.Valid {
+		t.Errorf("true XOR UNKNOWN = %+v, want UNKNOWN", got)
+	}
+}
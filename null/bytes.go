@@ -0,0 +1,119 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Bytes is a nullable []byte. Only an explicit JSON/SQL null is null; a nil
+// or empty slice passed to BytesFrom is still a valid, non-null value.
+type Bytes struct {
+	Bytes []byte
+	Valid bool
+}
+
+// NewBytes creates a new Bytes
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{Bytes: b, Valid: valid}
+}
+
+// BytesFrom creates a new valid Bytes.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, true)
+}
+
+// BytesFromPtr creates a new Bytes that will be null if b is nil.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return BytesFrom(*b)
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *Bytes) Scan(value interface{}) error {
+	if value == nil {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	switch v := value.(type) {
+	case []byte:
+		b.Bytes = append([]byte(nil), v...)
+	case string:
+		b.Bytes = []byte(v)
+	default:
+		return fmt.Errorf("null: cannot scan type %T into Bytes", value)
+	}
+	b.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (b Bytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bytes, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case string:
+		b.Bytes = []byte(x)
+		b.Valid = true
+	case nil:
+		b.Bytes, b.Valid = nil, false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.Bytes", v)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bytes is null.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(b.Bytes))
+}
+
+// SetValid changes this Bytes's value and also sets it to be non-null.
+func (b *Bytes) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Bytes's value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// IsZero returns true for null Bytes, for future omitempty support.
+func (b Bytes) IsZero() bool {
+	return !b.Valid
+}
+
+// OverwriteWithIfValid sets this Bytes's value to v if valid is true.
+func (b *Bytes) OverwriteWithIfValid(v []byte, valid bool) {
+	if valid {
+		b.Bytes = v
+		b.Valid = valid
+	}
+}
+
+// Equal reports whether b and other hold the same bytes and validity.
+func (b Bytes) Equal(other Bytes) bool {
+	return b.Valid == other.Valid && bytes.Equal(b.Bytes, other.Bytes)
+}
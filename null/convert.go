@@ -0,0 +1,83 @@
+package null
+
+import "github.com/BenAyedSeeksAI/null/zero"
+
+// Conversion helpers between this package's strict-null types and their
+// zero-value-is-null counterparts in the zero package, so downstream
+// code can change a column's null semantics without rewriting call sites.
+// These live here, rather than split across both packages, to avoid an
+// import cycle between null and zero: zero cannot import null, so there is
+// no zero.BoolToNull — the zero-to-null direction of each conversion lives
+// here instead, named <Type>FromZero (e.g. BoolFromZero is the zero.Bool ->
+// null.Bool conversion a caller porting a column off zero might expect to
+// find as zero.BoolToNull).
+
+// BoolToZero converts a strict-null Bool to a zero.Bool.
+func BoolToZero(b Bool) zero.Bool {
+	return zero.NewBool(b.Bool, b.Valid)
+}
+
+// BoolFromZero converts a zero.Bool to a strict-null Bool.
+func BoolFromZero(b zero.Bool) Bool {
+	return NewBool(b.Bool, b.Valid)
+}
+
+// StringToZero converts a strict-null String to a zero.String.
+func StringToZero(s String) zero.String {
+	return zero.NewString(s.String, s.Valid)
+}
+
+// StringFromZero converts a zero.String to a strict-null String.
+func StringFromZero(s zero.String) String {
+	return NewString(s.String, s.Valid)
+}
+
+// IntToZero converts a strict-null Int to a zero.Int.
+func IntToZero(i Int) zero.Int {
+	return zero.NewInt(i.Int64, i.Valid)
+}
+
+// IntFromZero converts a zero.Int to a strict-null Int.
+func IntFromZero(i zero.Int) Int {
+	return NewInt(i.Int64, i.Valid)
+}
+
+// Float64ToZero converts a strict-null Float64 to a zero.Float64.
+func Float64ToZero(f Float64) zero.Float64 {
+	return zero.NewFloat64(f.Float64, f.Valid)
+}
+
+// Float64FromZero converts a zero.Float64 to a strict-null Float64.
+func Float64FromZero(f zero.Float64) Float64 {
+	return NewFloat64(f.Float64, f.Valid)
+}
+
+// BytesToZero converts a strict-null Bytes to a zero.Bytes.
+func BytesToZero(b Bytes) zero.Bytes {
+	return zero.NewBytes(b.Bytes, b.Valid)
+}
+
+// BytesFromZero converts a zero.Bytes to a strict-null Bytes.
+func BytesFromZero(b zero.Bytes) Bytes {
+	return NewBytes(b.Bytes, b.Valid)
+}
+
+// TimeToZero converts a strict-null Time to a zero.Time.
+func TimeToZero(t Time) zero.Time {
+	return zero.NewTime(t.Time, t.Valid)
+}
+
+// TimeFromZero converts a zero.Time to a strict-null Time.
+func TimeFromZero(t zero.Time) Time {
+	return NewTime(t.Time, t.Valid)
+}
+
+// JSONToZero converts a strict-null JSON to a zero.JSON.
+func JSONToZero(j JSON) zero.JSON {
+	return zero.NewJSON(j.JSON, j.Valid)
+}
+
+// JSONFromZero converts a zero.JSON to a strict-null JSON.
+func JSONFromZero(j zero.JSON) JSON {
+	return NewJSON(j.JSON, j.Valid)
+}
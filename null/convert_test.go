@@ -0,0 +1,34 @@
+package null
+
+import "testing"
+
+func TestBoolConvertRoundTrip(t *testing.T) {
+	n := BoolFrom(true)
+	z := BoolToZero(n)
+	if !z.Valid || !z.Bool {
+		t.Errorf("BoolToZero(%+v) = %+v, want valid true", n, z)
+	}
+
+	back := BoolFromZero(z)
+	if !back.Valid || !back.Bool {
+		t.Errorf("BoolFromZero(%+v) = %+v, want valid true", z, back)
+	}
+
+	// A null strict-null Bool converts to a null zero.Bool.
+	null := NewBool(false, false)
+	if zb := BoolToZero(null); zb.Valid {
+		t.Errorf("BoolToZero(%+v) = %+v, want invalid", null, zb)
+	}
+}
+
+func TestIntConvertRoundTrip(t *testing.T) {
+	n := IntFrom(5)
+	z := IntToZero(n)
+	if !z.Valid || z.Int64 != 5 {
+		t.Errorf("IntToZero(%+v) = %+v, want valid 5", n, z)
+	}
+	back := IntFromZero(z)
+	if !back.Valid || back.Int64 != 5 {
+		t.Errorf("IntFromZero(%+v) = %+v, want valid 5", z, back)
+	}
+}
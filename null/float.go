@@ -0,0 +1,241 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Float64 is a nullable float64. Only an explicit JSON/SQL null is null.
+type Float64 struct {
+	sql.NullFloat64
+}
+
+// NewFloat64 creates a new Float64
+func NewFloat64(f float64, valid bool) Float64 {
+	return Float64{
+		NullFloat64: sql.NullFloat64{
+			Float64: f,
+			Valid:   valid,
+		},
+	}
+}
+
+// Float64From creates a new valid Float64.
+func Float64From(f float64) Float64 {
+	return NewFloat64(f, true)
+}
+
+// Float64FromPtr creates a new Float64 that will be null if f is nil.
+func Float64FromPtr(f *float64) Float64 {
+	if f == nil {
+		return NewFloat64(0, false)
+	}
+	return NewFloat64(*f, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *Float64) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		f.Float64 = x
+		f.Valid = true
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.Float64", v)
+	}
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *Float64) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return err
+	}
+	f.Float64 = v
+	f.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Float64 is null.
+func (f Float64) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f Float64) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+}
+
+// SetValid changes this Float64's value and also sets it to be non-null.
+func (f *Float64) SetValid(n float64) {
+	f.Float64 = n
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Float64's value, or a nil pointer if this Float64 is null.
+func (f Float64) Ptr() *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// IsZero returns true for null Float64s, for future omitempty support.
+func (f Float64) IsZero() bool {
+	return !f.Valid
+}
+
+// OverwriteWithIfValid sets this Float64's value to n if v is true.
+func (f *Float64) OverwriteWithIfValid(n float64, v bool) {
+	if v {
+		f.Float64 = n
+		f.Valid = v
+	}
+}
+
+// Float32 is a nullable float32. Only an explicit JSON/SQL null is null.
+type Float32 struct {
+	Float32 float32
+	Valid   bool
+}
+
+// NewFloat32 creates a new Float32
+func NewFloat32(f float32, valid bool) Float32 {
+	return Float32{Float32: f, Valid: valid}
+}
+
+// Float32From creates a new valid Float32.
+func Float32From(f float32) Float32 {
+	return NewFloat32(f, true)
+}
+
+// Float32FromPtr creates a new Float32 that will be null if f is nil.
+func Float32FromPtr(f *float32) Float32 {
+	if f == nil {
+		return NewFloat32(0, false)
+	}
+	return NewFloat32(*f, true)
+}
+
+// Scan implements the sql.Scanner interface.
+func (f *Float32) Scan(value interface{}) error {
+	if value == nil {
+		f.Float32, f.Valid = 0, false
+		return nil
+	}
+	var n sql.NullFloat64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	f.Float32, f.Valid = float32(n.Float64), n.Valid
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (f Float32) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return float64(f.Float32), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *Float32) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		f.Float32 = float32(x)
+		f.Valid = true
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.Float32", v)
+	}
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (f *Float32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseFloat(str, 32)
+	if err != nil {
+		return err
+	}
+	f.Float32 = float32(v)
+	f.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Float32 is null.
+func (f Float32) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (f Float32) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)), nil
+}
+
+// SetValid changes this Float32's value and also sets it to be non-null.
+func (f *Float32) SetValid(n float32) {
+	f.Float32 = n
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Float32's value, or a nil pointer if this Float32 is null.
+func (f Float32) Ptr() *float32 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float32
+}
+
+// IsZero returns true for null Float32s, for future omitempty support.
+func (f Float32) IsZero() bool {
+	return !f.Valid
+}
+
+// OverwriteWithIfValid sets this Float32's value to n if v is true.
+func (f *Float32) OverwriteWithIfValid(n float32, v bool) {
+	if v {
+		f.Float32 = n
+		f.Valid = v
+	}
+}
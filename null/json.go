@@ -0,0 +1,120 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// JSON is a nullable raw JSON payload. Only an explicit JSON/SQL null is
+// null; an empty payload passed to JSONFrom is still a valid, non-null
+// value. Scan validates that the stored payload is well-formed JSON.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool
+}
+
+// NewJSON creates a new JSON
+func NewJSON(b []byte, valid bool) JSON {
+	return JSON{JSON: b, Valid: valid}
+}
+
+// JSONFrom creates a new valid JSON.
+func JSONFrom(b []byte) JSON {
+	return NewJSON(b, true)
+}
+
+// JSONFromPtr creates a new JSON that will be null if b is nil.
+func JSONFromPtr(b *[]byte) JSON {
+	if b == nil {
+		return NewJSON(nil, false)
+	}
+	return JSONFrom(*b)
+}
+
+// Scan implements the sql.Scanner interface.
+// It returns an error if value does not hold well-formed JSON.
+func (j *JSON) Scan(value interface{}) error {
+	if value == nil {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	var raw []byte
+	switch v := value.(type) {
+	case []byte:
+		raw = append([]byte(nil), v...)
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("null: cannot scan type %T into JSON", value)
+	}
+	if len(raw) != 0 && !json.Valid(raw) {
+		return fmt.Errorf("null: invalid JSON payload: %s", raw)
+	}
+	j.JSON = raw
+	j.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (j JSON) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	return []byte(j.JSON), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	if !json.Valid(data) {
+		return fmt.Errorf("null: invalid JSON payload: %s", data)
+	}
+	j.JSON = append(json.RawMessage(nil), data...)
+	j.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this JSON is null.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if !j.Valid {
+		return []byte("null"), nil
+	}
+	if len(j.JSON) == 0 {
+		return []byte("null"), nil
+	}
+	return j.JSON, nil
+}
+
+// SetValid changes this JSON's value and also sets it to be non-null.
+func (j *JSON) SetValid(v []byte) {
+	j.JSON = v
+	j.Valid = true
+}
+
+// Ptr returns a pointer to this JSON's value, or a nil pointer if this JSON is null.
+func (j JSON) Ptr() *[]byte {
+	if !j.Valid {
+		return nil
+	}
+	b := []byte(j.JSON)
+	return &b
+}
+
+// IsZero returns true for null JSON, for future omitempty support.
+func (j JSON) IsZero() bool {
+	return !j.Valid
+}
+
+// OverwriteWithIfValid sets this JSON's value to v if valid is true.
+func (j *JSON) OverwriteWithIfValid(v []byte, valid bool) {
+	if valid {
+		j.JSON = v
+		j.Valid = valid
+	}
+}
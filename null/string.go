@@ -0,0 +1,113 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// String is a nullable string. Only an explicit JSON/SQL null is null; a
+// blank string is a valid, non-null value.
+type String struct {
+	sql.NullString
+}
+
+// NewString creates a new String
+func NewString(s string, valid bool) String {
+	return String{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
+
+// StringFrom creates a new valid String.
+func StringFrom(s string) String {
+	return NewString(s, true)
+}
+
+// StringFromPtr creates a new String that will be null if s is nil.
+func StringFromPtr(s *string) String {
+	if s == nil {
+		return NewString("", false)
+	}
+	return NewString(*s, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *String) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case string:
+		s.String = x
+		s.Valid = true
+	case nil:
+		s.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.String", v)
+	}
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *String) UnmarshalText(text []byte) error {
+	if string(text) == "null" {
+		s.Valid = false
+		return nil
+	}
+	s.String = string(text)
+	s.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this String is null.
+func (s String) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode an empty string if this String is null.
+func (s String) MarshalText() ([]byte, error) {
+	if !s.Valid {
+		return []byte{}, nil
+	}
+	return []byte(s.String), nil
+}
+
+// SetValid changes this String's value and also sets it to be non-null.
+func (s *String) SetValid(v string) {
+	s.String = v
+	s.Valid = true
+}
+
+// Ptr returns a pointer to this String's value, or a nil pointer if this String is null.
+func (s String) Ptr() *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// IsZero returns true for null Strings, for future omitempty support. Unlike
+// zero.String, a valid blank string is not considered zero.
+func (s String) IsZero() bool {
+	return !s.Valid
+}
+
+// OverwriteWithIfValid sets this String's value to v if valid is true. Used
+// for type conversion from sql.NullString to null.
+func (s *String) OverwriteWithIfValid(v string, valid bool) {
+	if valid {
+		s.String = v
+		s.Valid = valid
+	}
+}
@@ -0,0 +1,117 @@
+package null
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Time is a nullable time.Time. Only an explicit JSON/SQL null is null; the
+// zero time.Time is a valid, non-null value.
+type Time struct {
+	sql.NullTime
+}
+
+// NewTime creates a new Time
+func NewTime(t time.Time, valid bool) Time {
+	return Time{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// TimeFrom creates a new valid Time.
+func TimeFrom(t time.Time) Time {
+	return NewTime(t, true)
+}
+
+// TimeFromPtr creates a new Time that will be null if t is nil.
+func TimeFromPtr(t *time.Time) Time {
+	if t == nil {
+		return NewTime(time.Time{}, false)
+	}
+	return NewTime(*t, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v.(type) {
+	case string:
+		if err := t.Time.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		t.Valid = true
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.Time", v)
+	}
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *Time) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		t.Valid = false
+		return nil
+	}
+	if err := t.Time.UnmarshalText(text); err != nil {
+		return err
+	}
+	t.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Time is null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t Time) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return t.Time.MarshalText()
+}
+
+// SetValid changes this Time's value and also sets it to be non-null.
+func (t *Time) SetValid(v time.Time) {
+	t.Time = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this Time's value, or a nil pointer if this Time is null.
+func (t Time) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// IsZero returns true for null Times, for future omitempty support. Unlike
+// zero.Time, the zero-value time.Time is not considered zero.
+func (t Time) IsZero() bool {
+	return !t.Valid
+}
+
+// OverwriteWithIfValid sets this Time's value to v if valid is true.
+func (t *Time) OverwriteWithIfValid(v time.Time, valid bool) {
+	if valid {
+		t.Time = v
+		t.Valid = valid
+	}
+}
@@ -0,0 +1,458 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Uint is a nullable uint64. Only an explicit JSON/SQL null is null.
+type Uint struct {
+	Uint  uint64
+	Valid bool
+}
+
+// NewUint creates a new Uint
+func NewUint(u uint64, valid bool) Uint {
+	return Uint{Uint: u, Valid: valid}
+}
+
+// UintFrom creates a new valid Uint.
+func UintFrom(u uint64) Uint {
+	return NewUint(u, true)
+}
+
+// UintFromPtr creates a new Uint that will be null if u is nil.
+func UintFromPtr(u *uint64) Uint {
+	if u == nil {
+		return NewUint(0, false)
+	}
+	return NewUint(*u, true)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Uint) Scan(value interface{}) error {
+	if value == nil {
+		u.Uint, u.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	u.Uint, u.Valid = uint64(n.Int64), n.Valid
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (u Uint) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *Uint) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		u.Uint = uint64(x)
+		u.Valid = true
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.Uint", v)
+	}
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *Uint) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		u.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return err
+	}
+	u.Uint = v
+	u.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint is null.
+func (u Uint) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(u.Uint, 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u Uint) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte{}, nil
+	}
+	return []byte(strconv.FormatUint(u.Uint, 10)), nil
+}
+
+// SetValid changes this Uint's value and also sets it to be non-null.
+func (u *Uint) SetValid(n uint64) {
+	u.Uint = n
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
+func (u Uint) Ptr() *uint64 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint
+}
+
+// IsZero returns true for null Uints, for future omitempty support. Unlike
+// zero.Uint, a valid 0 is not considered zero.
+func (u Uint) IsZero() bool {
+	return !u.Valid
+}
+
+// OverwriteWithIfValid sets this Uint's value to n if v is true.
+func (u *Uint) OverwriteWithIfValid(n uint64, v bool) {
+	if v {
+		u.Uint = n
+		u.Valid = v
+	}
+}
+
+// Uint8 is a nullable uint8. Only an explicit JSON/SQL null is null.
+type Uint8 struct {
+	Uint8 uint8
+	Valid bool
+}
+
+// NewUint8 creates a new Uint8
+func NewUint8(u uint8, valid bool) Uint8 {
+	return Uint8{Uint8: u, Valid: valid}
+}
+
+// Uint8From creates a new valid Uint8.
+func Uint8From(u uint8) Uint8 {
+	return NewUint8(u, true)
+}
+
+// Uint8FromPtr creates a new Uint8 that will be null if u is nil.
+func Uint8FromPtr(u *uint8) Uint8 {
+	if u == nil {
+		return NewUint8(0, false)
+	}
+	return NewUint8(*u, true)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Uint8) Scan(value interface{}) error {
+	if value == nil {
+		u.Uint8, u.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	u.Uint8, u.Valid = uint8(n.Int64), n.Valid
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (u Uint8) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint8), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *Uint8) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		u.Uint8 = uint8(x)
+		u.Valid = true
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.Uint8", v)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Uint8) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint8), 10)), nil
+}
+
+// SetValid changes this Uint8's value and also sets it to be non-null.
+func (u *Uint8) SetValid(n uint8) {
+	u.Uint8 = n
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
+func (u Uint8) Ptr() *uint8 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint8
+}
+
+// IsZero returns true for null Uint8s, for future omitempty support. Unlike
+// zero.Uint8, a valid 0 is not considered zero.
+func (u Uint8) IsZero() bool {
+	return !u.Valid
+}
+
+// OverwriteWithIfValid sets this Uint8's value to n if v is true.
+func (u *Uint8) OverwriteWithIfValid(n uint8, v bool) {
+	if v {
+		u.Uint8 = n
+		u.Valid = v
+	}
+}
+
+// Uint16 is a nullable uint16. Only an explicit JSON/SQL null is null.
+type Uint16 struct {
+	Uint16 uint16
+	Valid  bool
+}
+
+// NewUint16 creates a new Uint16
+func NewUint16(u uint16, valid bool) Uint16 {
+	return Uint16{Uint16: u, Valid: valid}
+}
+
+// Uint16From creates a new valid Uint16.
+func Uint16From(u uint16) Uint16 {
+	return NewUint16(u, true)
+}
+
+// Uint16FromPtr creates a new Uint16 that will be null if u is nil.
+func Uint16FromPtr(u *uint16) Uint16 {
+	if u == nil {
+		return NewUint16(0, false)
+	}
+	return NewUint16(*u, true)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Uint16) Scan(value interface{}) error {
+	if value == nil {
+		u.Uint16, u.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	u.Uint16, u.Valid = uint16(n.Int64), n.Valid
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (u Uint16) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint16), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *Uint16) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		u.Uint16 = uint16(x)
+		u.Valid = true
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.Uint16", v)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Uint16) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint16), 10)), nil
+}
+
+// SetValid changes this Uint16's value and also sets it to be non-null.
+func (u *Uint16) SetValid(n uint16) {
+	u.Uint16 = n
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
+func (u Uint16) Ptr() *uint16 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint16
+}
+
+// IsZero returns true for null Uint16s, for future omitempty support. Unlike
+// zero.Uint16, a valid 0 is not considered zero.
+func (u Uint16) IsZero() bool {
+	return !u.Valid
+}
+
+// OverwriteWithIfValid sets this Uint16's value to n if v is true.
+func (u *Uint16) OverwriteWithIfValid(n uint16, v bool) {
+	if v {
+		u.Uint16 = n
+		u.Valid = v
+	}
+}
+
+// Uint32 is a nullable uint32. Only an explicit JSON/SQL null is null.
+type Uint32 struct {
+	Uint32 uint32
+	Valid  bool
+}
+
+// NewUint32 creates a new Uint32
+func NewUint32(u uint32, valid bool) Uint32 {
+	return Uint32{Uint32: u, Valid: valid}
+}
+
+// Uint32From creates a new valid Uint32.
+func Uint32From(u uint32) Uint32 {
+	return NewUint32(u, true)
+}
+
+// Uint32FromPtr creates a new Uint32 that will be null if u is nil.
+func Uint32FromPtr(u *uint32) Uint32 {
+	if u == nil {
+		return NewUint32(0, false)
+	}
+	return NewUint32(*u, true)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Uint32) Scan(value interface{}) error {
+	if value == nil {
+		u.Uint32, u.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	u.Uint32, u.Valid = uint32(n.Int64), n.Valid
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (u Uint32) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint32), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (u *Uint32) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		u.Uint32 = uint32(x)
+		u.Valid = true
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type null.Uint32", v)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (u Uint32) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint32), 10)), nil
+}
+
+// SetValid changes this Uint32's value and also sets it to be non-null.
+func (u *Uint32) SetValid(n uint32) {
+	u.Uint32 = n
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
+func (u Uint32) Ptr() *uint32 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint32
+}
+
+// IsZero returns true for null Uint32s, for future omitempty support. Unlike
+// zero.Uint32, a valid 0 is not considered zero.
+func (u Uint32) IsZero() bool {
+	return !u.Valid
+}
+
+// OverwriteWithIfValid sets this Uint32's value to n if v is true.
+func (u *Uint32) OverwriteWithIfValid(n uint32, v bool) {
+	if v {
+		u.Uint32 = n
+		u.Valid = v
+	}
+}
+
+// Uint64 is an alias of Uint, provided so the sized Uint8/16/32/64 family is
+// complete. It is a nullable uint64 where only an explicit JSON/SQL null is
+// null.
+type Uint64 = Uint
+
+// NewUint64 creates a new Uint64 (Uint).
+func NewUint64(u uint64, valid bool) Uint64 {
+	return NewUint(u, valid)
+}
+
+// Uint64From creates a new valid Uint64.
+func Uint64From(u uint64) Uint64 {
+	return UintFrom(u)
+}
+
+// Uint64FromPtr creates a new Uint64 that will be null if u is nil.
+func Uint64FromPtr(u *uint64) Uint64 {
+	return UintFromPtr(u)
+}
@@ -2,6 +2,7 @@ package zero
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -75,6 +76,60 @@ func BoolFromStringExist(s string, b bool) Bool {
 	return NewBool(true, true)
 }
 
+// Scan implements the sql.Scanner interface.
+// It is more tolerant than the embedded sql.NullBool.Scan: besides a native
+// bool, int64 (nonzero is true), and float64, it accepts the string forms
+// BoolFromString does plus "t"/"f"/"y"/"n"/"Y"/"N", so a driver that hands
+// back "1"/"0"/"true" (or their casing variants) as a string or []byte still
+// scans correctly. Unlike BoolFromString, an empty string scans as null.
+func (b *Bool) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		b.Bool, b.Valid = false, false
+		return nil
+	case bool:
+		b.Bool, b.Valid = v, true
+		return nil
+	case int64:
+		b.Bool, b.Valid = v != 0, true
+		return nil
+	case float64:
+		b.Bool, b.Valid = v != 0, true
+		return nil
+	case []byte:
+		return b.scanString(string(v))
+	case string:
+		return b.scanString(v)
+	default:
+		return fmt.Errorf("zero: cannot scan type %T into Bool", value)
+	}
+}
+
+// scanString maps the string forms a database driver may hand back for a
+// boolean-ish column; an empty string is null.
+func (b *Bool) scanString(s string) error {
+	switch s {
+	case "":
+		b.Bool, b.Valid = false, false
+	case "1", "true", "True", "TRUE", "t", "y", "Y":
+		b.Bool, b.Valid = true, true
+	case "0", "false", "False", "FALSE", "f", "n", "N":
+		b.Bool, b.Valid = false, true
+	default:
+		return fmt.Errorf("zero: cannot scan %q into Bool", s)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It emits nil when this Bool is null, and a native bool otherwise.
+func (b Bool) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bool, nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // "false" will be considered a null Bool.
 // It also supports unmarshalling a sql.NullBool.
@@ -157,6 +212,11 @@ func (b Bool) IsZero() bool {
 	return !b.Valid || !b.Bool
 }
 
+// IsUnknown returns true if this Bool represents SQL UNKNOWN, i.e. it is null.
+func (b Bool) IsUnknown() bool {
+	return !b.Valid
+}
+
 // OverwriteWithIfValid returns nothing. Used for type conversion from sql.Nullstring to zero
 func (s *Bool) OverwriteWithIfValid(st bool, v bool) {
 	if v {
@@ -166,53 +226,59 @@ func (s *Bool) OverwriteWithIfValid(st bool, v bool) {
 }
 
 // Add boolean operators
-// AND operation
+//
+// AND, OR and XOR implement SQL's three-valued (Kleene) logic: a Bool with
+// Valid=false stands for UNKNOWN, not false, and UNKNOWN propagates the same
+// way NULL does in a SQL WHERE clause.
+
+// AND operation.
+// Returns a valid false if either operand is a valid false, regardless of
+// the other operand's validity. Otherwise returns UNKNOWN (Valid=false) if
+// either operand is UNKNOWN, and the conjunction of both values otherwise.
 func (s Bool) AND(other Bool) Bool {
-	result := Bool{
-		NullBool: sql.NullBool{},
+	if s.Valid && !s.Bool {
+		return NewBool(false, true)
+	}
+	if other.Valid && !other.Bool {
+		return NewBool(false, true)
 	}
-	if s.Valid && other.Valid {
-		result.Bool = s.Bool && other.Bool
-		result.Valid = true
-		return result
+	if !s.Valid || !other.Valid {
+		return NewBool(false, false)
 	}
-	result.Valid = false
-	return result
+	return NewBool(s.Bool && other.Bool, true)
 }
 
-// OR operation
+// OR operation.
+// Returns a valid true if either operand is a valid true, regardless of the
+// other operand's validity. Otherwise returns UNKNOWN (Valid=false) if either
+// operand is UNKNOWN, and the disjunction of both values otherwise.
 func (s Bool) OR(other Bool) Bool {
-	result := Bool{
-		NullBool: sql.NullBool{},
+	if s.Valid && s.Bool {
+		return NewBool(true, true)
+	}
+	if other.Valid && other.Bool {
+		return NewBool(true, true)
 	}
-	if s.Valid && other.Valid {
-		result.Bool = s.Bool || other.Bool
-		result.Valid = true
-		return result
+	if !s.Valid || !other.Valid {
+		return NewBool(false, false)
 	}
-	result.Valid = false
-	return result
+	return NewBool(s.Bool || other.Bool, true)
 }
 
-// NON operation
+// NON operation.
+// Leaves s unchanged (UNKNOWN) if s is UNKNOWN.
 func (s *Bool) NON() {
 	if s.Valid {
 		s.Bool = !s.Bool
 	}
 }
 
-// XOR operation
+// XOR operation.
+// Returns UNKNOWN (Valid=false) whenever either operand is UNKNOWN, since
+// the result of XOR cannot be determined without both values.
 func (s Bool) XOR(other Bool) Bool {
-	result := Bool{
-		NullBool: sql.NullBool{},
-	}
-	x := s.Bool
-	y := other.Bool
-	if s.Valid && other.Valid {
-		result.Bool = (x || y) && !(x && y)
-		result.Valid = true
-		return result
-	}
-	result.Valid = false
-	return result
+	if !s.Valid || !other.Valid {
+		return NewBool(false, false)
+	}
+	return NewBool(s.Bool != other.Bool, true)
 }
@@ -0,0 +1,76 @@
+package zero
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBoolScanDriverValueFlavors(t *testing.T) {
+	cases := []struct {
+		name  string
+		value interface{}
+		want  Bool
+	}{
+		{"bool true", true, NewBool(true, true)},
+		{"bool false", false, NewBool(false, true)},
+		{"int64 nonzero", int64(1), NewBool(true, true)},
+		{"int64 zero", int64(0), NewBool(false, true)},
+		{"float64 nonzero", float64(1), NewBool(true, true)},
+		{"string 1", "1", NewBool(true, true)},
+		{"string 0", "0", NewBool(false, true)},
+		{"string true", "true", NewBool(true, true)},
+		{"string False", "False", NewBool(false, true)},
+		{"string y", "y", NewBool(true, true)},
+		{"string N", "N", NewBool(false, true)},
+		{"bytes true", []byte("true"), NewBool(true, true)},
+		{"empty string", "", NewBool(false, false)},
+		{"nil", nil, NewBool(false, false)},
+	}
+
+	for _, c := range cases {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("sqlmock.New: %v", err)
+		}
+
+		rows := sqlmock.NewRows([]string{"flag"}).AddRow(c.value)
+		mock.ExpectQuery("SELECT flag FROM t").WillReturnRows(rows)
+
+		var got Bool
+		row := db.QueryRow("SELECT flag FROM t")
+		if err := row.Scan(&got); err != nil {
+			t.Errorf("%s: Scan: %v", c.name, err)
+			db.Close()
+			continue
+		}
+		if got.Valid != c.want.Valid || (got.Valid && got.Bool != c.want.Bool) {
+			t.Errorf("%s: got %+v, want %+v", c.name, got, c.want)
+		}
+		db.Close()
+	}
+}
+
+func TestBoolValueRoundTrip(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer db.Close()
+
+	in := NewBool(true, true)
+	mock.ExpectExec("INSERT INTO t").WithArgs(in).WillReturnResult(sqlmock.NewResult(1, 1))
+	if _, err := db.Exec("INSERT INTO t (flag) VALUES (?)", in); err != nil {
+		t.Fatalf("insert valid: %v", err)
+	}
+
+	null := NewBool(false, false)
+	mock.ExpectExec("INSERT INTO t").WithArgs(null).WillReturnResult(sqlmock.NewResult(2, 1))
+	if _, err := db.Exec("INSERT INTO t (flag) VALUES (?)", null); err != nil {
+		t.Fatalf("insert null: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package zero
+
+import "testing"
+
+// unknown is a convenience UNKNOWN (invalid) Bool for the truth tables below.
+var unknown = NewBool(false, false)
+
+func boolStr(b Bool) string {
+	if !b.Valid {
+		return "UNKNOWN"
+	}
+	if b.Bool {
+		return "true"
+	}
+	return "false"
+}
+
+func TestBoolAND(t *testing.T) {
+	tTrue := BoolFrom(true)
+	tFalse := NewBool(false, true)
+
+	cases := []struct {
+		a, b Bool
+		want Bool
+	}{
+		{tTrue, tTrue, tTrue},
+		{tTrue, tFalse, tFalse},
+		{tTrue, unknown, unknown},
+		{tFalse, tTrue, tFalse},
+		{tFalse, tFalse, tFalse},
+		{tFalse, unknown, tFalse},
+		{unknown, tTrue, unknown},
+		{unknown, tFalse, tFalse},
+		{unknown, unknown, unknown},
+	}
+	for _, c := range cases {
+		got := c.a.AND(c.b)
+		if got.Valid != c.want.Valid || (got.Valid && got.Bool != c.want.Bool) {
+			t.Errorf("%s AND %s = %s, want %s", boolStr(c.a), boolStr(c.b), boolStr(got), boolStr(c.want))
+		}
+	}
+}
+
+func TestBoolOR(t *testing.T) {
+	tTrue := BoolFrom(true)
+	tFalse := NewBool(false, true)
+
+	cases := []struct {
+		a, b Bool
+		want Bool
+	}{
+		{tTrue, tTrue, tTrue},
+		{tTrue, tFalse, tTrue},
+		{tTrue, unknown, tTrue},
+		{tFalse, tTrue, tTrue},
+		{tFalse, tFalse, tFalse},
+		{tFalse, unknown, unknown},
+		{unknown, tTrue, tTrue},
+		{unknown, tFalse, unknown},
+		{unknown, unknown, unknown},
+	}
+	for _, c := range cases {
+		got := c.a.OR(c.b)
+		if got.Valid != c.want.Valid || (got.Valid && got.Bool != c.want.Bool) {
+			t.Errorf("%s OR %s = %s, want %s", boolStr(c.a), boolStr(c.b), boolStr(got), boolStr(c.want))
+		}
+	}
+}
+
+func TestBoolXOR(t *testing.T) {
+	tTrue := BoolFrom(true)
+	tFalse := NewBool(false, true)
+
+	cases := []struct {
+		a, b Bool
+		want Bool
+	}{
+		{tTrue, tTrue, tFalse},
+		{tTrue, tFalse, tTrue},
+		{tTrue, unknown, unknown},
+		{tFalse, tTrue, tTrue},
+		{tFalse, tFalse, tFalse},
+		{tFalse, unknown, unknown},
+		{unknown, tTrue, unknown},
+		{unknown, tFalse, unknown},
+		{unknown, unknown, unknown},
+	}
+	for _, c := range cases {
+		got := c.a.XOR(c.b)
+		if got.Valid != c.want.Valid || (got.Valid && got.Bool != c.want.Bool) {
+			t.Errorf("%s XOR %s = %s, want %s", boolStr(c.a), boolStr(c.b), boolStr(got), boolStr(c.want))
+		}
+	}
+}
+
+func TestBoolIsUnknown(t *testing.T) {
+	if !unknown.IsUnknown() {
+		t.Error("expected UNKNOWN Bool to report IsUnknown() == true")
+	}
+	if BoolFrom(true).IsUnknown() {
+		t.Error("expected valid Bool to report IsUnknown() == false")
+	}
+}
@@ -0,0 +1,143 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Bytes is a nullable []byte. A nil or length-zero slice is considered null.
+// JSON marshals to null if null; on unmarshal both a JSON null and an empty
+// value are accepted as null.
+type Bytes struct {
+	Bytes []byte
+	Valid bool
+}
+
+// NewBytes creates a new Bytes
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{Bytes: b, Valid: valid}
+}
+
+// BytesFrom creates a new Bytes that will be null if b is nil or empty.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, len(b) != 0)
+}
+
+// BytesFromPtr creates a new Bytes that be null if b is nil.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return BytesFrom(*b)
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *Bytes) Scan(value interface{}) error {
+	if value == nil {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	switch v := value.(type) {
+	case []byte:
+		b.Bytes = append([]byte(nil), v...)
+	case string:
+		b.Bytes = []byte(v)
+	default:
+		return fmt.Errorf("zero: cannot scan type %T into Bytes", value)
+	}
+	b.Valid = len(b.Bytes) != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (b Bytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bytes, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// A JSON null or empty string is considered a null Bytes; any other
+// non-string JSON value is an error.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case string:
+		b.Bytes = []byte(x)
+	case nil:
+		b.Bytes, b.Valid = nil, false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Bytes", v)
+	}
+	b.Valid = len(b.Bytes) != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Bytes if the input is blank.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	if string(text) == "null" {
+		b.Bytes, b.Valid = nil, false
+		return nil
+	}
+	b.Bytes = append([]byte(nil), text...)
+	b.Valid = len(b.Bytes) != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bytes is null.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid || len(b.Bytes) == 0 {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(b.Bytes))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode nothing if this Bytes is null.
+func (b Bytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return b.Bytes, nil
+}
+
+// SetValid changes this Bytes's value and also sets it to be non-null.
+func (b *Bytes) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Bytes's value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// IsZero returns true for null or empty Bytes, for future omitempty support.
+func (b Bytes) IsZero() bool {
+	return !b.Valid || len(b.Bytes) == 0
+}
+
+// OverwriteWithIfValid sets this Bytes's value to v if valid is true.
+func (b *Bytes) OverwriteWithIfValid(v []byte, valid bool) {
+	if valid {
+		b.Bytes = v
+		b.Valid = valid
+	}
+}
+
+// Equal reports whether b and other hold the same bytes and validity.
+func (b Bytes) Equal(other Bytes) bool {
+	return b.Valid == other.Valid && bytes.Equal(b.Bytes, other.Bytes)
+}
@@ -0,0 +1,119 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// BoolEncoding describes the on-wire string representation of a Bool column
+// that isn't a native SQL BOOLEAN, e.g. a CHAR(1) column storing "Y"/"N" as
+// is common on Oracle and older PostgreSQL schemas.
+type BoolEncoding struct {
+	// True and False are the strings written to and recognized from the
+	// database for the true and false values, respectively.
+	True  string
+	False string
+	// NullAsEmpty, when set, treats an empty string as null on Scan and
+	// writes an empty string (instead of a SQL NULL) on Value.
+	NullAsEmpty bool
+	// CaseInsensitive, when set, compares scanned strings against True and
+	// False without regard to case.
+	CaseInsensitive bool
+}
+
+// Common BoolEncodings for CHAR(1)-style boolean columns.
+var (
+	YNEncoding      = BoolEncoding{True: "y", False: "n"}
+	TFEncoding      = BoolEncoding{True: "T", False: "F"}
+	YesNoEncoding   = BoolEncoding{True: "Y", False: "N"}
+	OneZeroEncoding = BoolEncoding{True: "1", False: "0"}
+)
+
+// DefaultBoolEncoding is the encoding EnumBoolFrom uses. It defaults to
+// YNEncoding. A zero-value EnumBool has an empty Encoding, not this one.
+var DefaultBoolEncoding = YNEncoding
+
+func (e BoolEncoding) equal(s, want string) bool {
+	if e.CaseInsensitive {
+		return strings.EqualFold(s, want)
+	}
+	return s == want
+}
+
+// EnumBool is a nullable bool whose SQL representation is a string encoded
+// according to Encoding rather than a native BOOLEAN. It wraps Bool so it
+// retains the same JSON/text/Kleene-logic behavior, but overrides Scan and
+// Value to translate to and from the configured wire representation.
+type EnumBool struct {
+	Bool
+	Encoding BoolEncoding
+}
+
+// NewEnumBool creates a new EnumBool with the given encoding.
+func NewEnumBool(b bool, valid bool, encoding BoolEncoding) EnumBool {
+	return EnumBool{
+		Bool:     NewBool(b, valid),
+		Encoding: encoding,
+	}
+}
+
+// EnumBoolFrom creates a new valid EnumBool using DefaultBoolEncoding.
+func EnumBoolFrom(b bool) EnumBool {
+	return NewEnumBool(b, true, DefaultBoolEncoding)
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts bool, []byte, and string values, translating strings through
+// Encoding. A nil value, or an empty string when Encoding.NullAsEmpty is
+// set, scans as null.
+func (e *EnumBool) Scan(value interface{}) error {
+	if value == nil {
+		e.Bool = NewBool(false, false)
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case bool:
+		e.Bool = NewBool(v, true)
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return fmt.Errorf("zero: cannot scan type %T into EnumBool", value)
+	}
+
+	if s == "" && e.Encoding.NullAsEmpty {
+		e.Bool = NewBool(false, false)
+		return nil
+	}
+
+	switch {
+	case e.Encoding.equal(s, e.Encoding.True):
+		e.Bool = NewBool(true, true)
+	case e.Encoding.equal(s, e.Encoding.False):
+		e.Bool = NewBool(false, true)
+	default:
+		return fmt.Errorf("zero: cannot scan %q into EnumBool with encoding %+v", s, e.Encoding)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It emits the configured True/False string, or nil (or "" if
+// Encoding.NullAsEmpty is set) when the EnumBool is null.
+func (e EnumBool) Value() (driver.Value, error) {
+	if !e.Valid {
+		if e.Encoding.NullAsEmpty {
+			return "", nil
+		}
+		return nil, nil
+	}
+	if e.Bool.Bool {
+		return e.Encoding.True, nil
+	}
+	return e.Encoding.False, nil
+}
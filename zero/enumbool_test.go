@@ -0,0 +1,96 @@
+package zero
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestEnumBoolRoundTrip(t *testing.T) {
+	encodings := []BoolEncoding{YNEncoding, TFEncoding, YesNoEncoding, OneZeroEncoding}
+
+	for _, enc := range encodings {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("sqlmock.New: %v", err)
+		}
+		defer db.Close()
+
+		in := NewEnumBool(true, true, enc)
+		mock.ExpectExec("INSERT INTO t").WithArgs(in).WillReturnResult(sqlmock.NewResult(1, 1))
+		if _, err := db.Exec("INSERT INTO t (flag) VALUES (?)", in); err != nil {
+			t.Fatalf("encoding %+v: insert: %v", enc, err)
+		}
+
+		rows := sqlmock.NewRows([]string{"flag"}).AddRow(enc.True)
+		mock.ExpectQuery("SELECT flag FROM t").WillReturnRows(rows)
+
+		var out EnumBool
+		out.Encoding = enc
+		row := db.QueryRow("SELECT flag FROM t")
+		if err := row.Scan(&out); err != nil {
+			t.Fatalf("encoding %+v: scan: %v", enc, err)
+		}
+		if !out.Valid || !out.Bool.Bool {
+			t.Errorf("encoding %+v: got %+v, want valid true", enc, out)
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("encoding %+v: unmet expectations: %v", enc, err)
+		}
+	}
+}
+
+func TestEnumBoolScanNull(t *testing.T) {
+	var b EnumBool
+	b.Encoding = YNEncoding
+	if err := b.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil): %v", err)
+	}
+	if b.Valid {
+		t.Error("expected Scan(nil) to leave EnumBool invalid")
+	}
+
+	v, err := b.Value()
+	if err != nil {
+		t.Fatalf("Value: %v", err)
+	}
+	if v != nil {
+		t.Errorf("Value() = %v, want nil", v)
+	}
+}
+
+func TestEnumBoolNullAsEmpty(t *testing.T) {
+	enc := BoolEncoding{True: "y", False: "n", NullAsEmpty: true}
+	var b EnumBool
+	b.Encoding = enc
+	if err := b.Scan(""); err != nil {
+		t.Fatalf("Scan(\"\"): %v", err)
+	}
+	if b.Valid {
+		t.Error("expected empty string to scan as invalid when NullAsEmpty is set")
+	}
+
+	v, err := b.Value()
+	if err != nil {
+		t.Fatalf("Value: %v", err)
+	}
+	if v != "" {
+		t.Errorf("Value() = %v, want empty string", v)
+	}
+}
+
+func TestEnumBoolCaseInsensitive(t *testing.T) {
+	enc := BoolEncoding{True: "Y", False: "N", CaseInsensitive: true}
+	var b EnumBool
+	b.Encoding = enc
+	if err := b.Scan("y"); err != nil {
+		t.Fatalf("Scan(\"y\"): %v", err)
+	}
+	if !b.Valid || !b.Bool.Bool {
+		t.Errorf("got %+v, want valid true", b)
+	}
+}
+
+var _ sql.Scanner = (*EnumBool)(nil)
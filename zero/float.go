@@ -0,0 +1,266 @@
+package zero
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Float64 is a nullable float64. Zero input is considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL unmarshaled from a zero value.
+type Float64 struct {
+	sql.NullFloat64
+}
+
+// NewFloat64 creates a new Float64
+func NewFloat64(f float64, valid bool) Float64 {
+	return Float64{
+		NullFloat64: sql.NullFloat64{
+			Float64: f,
+			Valid:   valid,
+		},
+	}
+}
+
+// Float64From creates a new Float64 that will be null if zero.
+func Float64From(f float64) Float64 {
+	return NewFloat64(f, f != 0)
+}
+
+// Float64FromPtr creates a new Float64 that be null if f is nil.
+func Float64FromPtr(f *float64) Float64 {
+	if f == nil {
+		return NewFloat64(0, false)
+	}
+	return NewFloat64(*f, *f != 0)
+}
+
+// Scan implements the sql.Scanner interface. It overrides the embedded
+// sql.NullFloat64.Scan so that a scanned zero is treated as null, matching
+// every sized sibling in this package.
+func (f *Float64) Scan(value interface{}) error {
+	if value == nil {
+		f.Float64, f.Valid = 0, false
+		return nil
+	}
+	var n sql.NullFloat64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	f.Float64, f.Valid = n.Float64, n.Valid && n.Float64 != 0
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Float64.
+func (f *Float64) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		f.Float64 = x
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Float64", v)
+	}
+	f.Valid = f.Float64 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Float64 if the input is blank or zero.
+func (f *Float64) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return err
+	}
+	f.Float64 = v
+	f.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Float64 is null.
+func (f Float64) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Float64 is null.
+func (f Float64) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+}
+
+// SetValid changes this Float64's value and also sets it to be non-null.
+func (f *Float64) SetValid(n float64) {
+	f.Float64 = n
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Float64's value, or a nil pointer if this Float64 is null.
+func (f Float64) Ptr() *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// IsZero returns true for null or zero Float64s, for future omitempty support.
+func (f Float64) IsZero() bool {
+	return !f.Valid || f.Float64 == 0
+}
+
+// OverwriteWithIfValid sets this Float64's value to n if v is true. Used for
+// type conversion from sql.NullFloat64 to zero.
+func (f *Float64) OverwriteWithIfValid(n float64, v bool) {
+	if v {
+		f.Float64 = n
+		f.Valid = v
+	}
+}
+
+// Float32 is a nullable float32. Zero input is considered null.
+type Float32 struct {
+	Float32 float32
+	Valid   bool
+}
+
+// NewFloat32 creates a new Float32
+func NewFloat32(f float32, valid bool) Float32 {
+	return Float32{Float32: f, Valid: valid}
+}
+
+// Float32From creates a new Float32 that will be null if zero.
+func Float32From(f float32) Float32 {
+	return NewFloat32(f, f != 0)
+}
+
+// Float32FromPtr creates a new Float32 that be null if f is nil.
+func Float32FromPtr(f *float32) Float32 {
+	if f == nil {
+		return NewFloat32(0, false)
+	}
+	return NewFloat32(*f, *f != 0)
+}
+
+// Scan implements the sql.Scanner interface.
+func (f *Float32) Scan(value interface{}) error {
+	if value == nil {
+		f.Float32, f.Valid = 0, false
+		return nil
+	}
+	var n sql.NullFloat64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	f.Float32, f.Valid = float32(n.Float64), n.Valid && n.Float64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (f Float32) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return float64(f.Float32), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Float32.
+func (f *Float32) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		f.Float32 = float32(x)
+	case nil:
+		f.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Float32", v)
+	}
+	f.Valid = f.Float32 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Float32 if the input is blank or zero.
+func (f *Float32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseFloat(str, 32)
+	if err != nil {
+		return err
+	}
+	f.Float32 = float32(v)
+	f.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Float32 is null.
+func (f Float32) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Float32 is null.
+func (f Float32) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)), nil
+}
+
+// SetValid changes this Float32's value and also sets it to be non-null.
+func (f *Float32) SetValid(n float32) {
+	f.Float32 = n
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Float32's value, or a nil pointer if this Float32 is null.
+func (f Float32) Ptr() *float32 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float32
+}
+
+// IsZero returns true for null or zero Float32s, for future omitempty support.
+func (f Float32) IsZero() bool {
+	return !f.Valid || f.Float32 == 0
+}
+
+// OverwriteWithIfValid sets this Float32's value to n if v is true.
+func (f *Float32) OverwriteWithIfValid(n float32, v bool) {
+	if v {
+		f.Float32 = n
+		f.Valid = v
+	}
+}
@@ -0,0 +1,543 @@
+package zero
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Int is a nullable int64. Zero input is considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL unmarshaled from a zero value.
+type Int struct {
+	sql.NullInt64
+}
+
+// NewInt creates a new Int
+func NewInt(i int64, valid bool) Int {
+	return Int{
+		NullInt64: sql.NullInt64{
+			Int64: i,
+			Valid: valid,
+		},
+	}
+}
+
+// IntFrom creates a new Int that will be null if zero.
+func IntFrom(i int64) Int {
+	return NewInt(i, i != 0)
+}
+
+// IntFromPtr creates a new Int that be null if i is nil.
+func IntFromPtr(i *int64) Int {
+	if i == nil {
+		return NewInt(0, false)
+	}
+	return NewInt(*i, *i != 0)
+}
+
+// Scan implements the sql.Scanner interface. It overrides the embedded
+// sql.NullInt64.Scan so that a scanned zero is treated as null, matching
+// every sized sibling in this package.
+func (i *Int) Scan(value interface{}) error {
+	if value == nil {
+		i.Int64, i.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	i.Int64, i.Valid = n.Int64, n.Valid && n.Int64 != 0
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Int.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		i.Int64 = int64(x)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Int", v)
+	}
+	i.Valid = i.Int64 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int if the input is blank or zero.
+func (i *Int) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return err
+	}
+	i.Int64 = v
+	i.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int is null.
+func (i Int) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int is null.
+func (i Int) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+}
+
+// SetValid changes this Int's value and also sets it to be non-null.
+func (i *Int) SetValid(n int64) {
+	i.Int64 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int's value, or a nil pointer if this Int is null.
+func (i Int) Ptr() *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int64
+}
+
+// IsZero returns true for null or zero Ints, for future omitempty support.
+func (i Int) IsZero() bool {
+	return !i.Valid || i.Int64 == 0
+}
+
+// OverwriteWithIfValid sets this Int's value to n if v is true. Used for type
+// conversion from sql.NullInt64 to zero.
+func (i *Int) OverwriteWithIfValid(n int64, v bool) {
+	if v {
+		i.Int64 = n
+		i.Valid = v
+	}
+}
+
+// Int8 is a nullable int8. Zero input is considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL unmarshaled from a zero value.
+type Int8 struct {
+	Int8  int8
+	Valid bool
+}
+
+// NewInt8 creates a new Int8
+func NewInt8(i int8, valid bool) Int8 {
+	return Int8{Int8: i, Valid: valid}
+}
+
+// Int8From creates a new Int8 that will be null if zero.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, i != 0)
+}
+
+// Int8FromPtr creates a new Int8 that be null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return NewInt8(0, false)
+	}
+	return NewInt8(*i, *i != 0)
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Int8) Scan(value interface{}) error {
+	if value == nil {
+		i.Int8, i.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	i.Int8, i.Valid = int8(n.Int64), n.Valid && n.Int64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Int8) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Int8), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Int8.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		i.Int8 = int8(x)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Int8", v)
+	}
+	i.Valid = i.Int8 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int8 if the input is blank or zero.
+func (i *Int8) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseInt(str, 10, 8)
+	if err != nil {
+		return err
+	}
+	i.Int8 = int8(v)
+	i.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int8 is null.
+func (i Int8) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int8), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int8 is null.
+func (i Int8) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int8), 10)), nil
+}
+
+// SetValid changes this Int8's value and also sets it to be non-null.
+func (i *Int8) SetValid(n int8) {
+	i.Int8 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
+func (i Int8) Ptr() *int8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int8
+}
+
+// IsZero returns true for null or zero Int8s, for future omitempty support.
+func (i Int8) IsZero() bool {
+	return !i.Valid || i.Int8 == 0
+}
+
+// OverwriteWithIfValid sets this Int8's value to n if v is true.
+func (i *Int8) OverwriteWithIfValid(n int8, v bool) {
+	if v {
+		i.Int8 = n
+		i.Valid = v
+	}
+}
+
+// Int16 is a nullable int16. Zero input is considered null.
+type Int16 struct {
+	Int16 int16
+	Valid bool
+}
+
+// NewInt16 creates a new Int16
+func NewInt16(i int16, valid bool) Int16 {
+	return Int16{Int16: i, Valid: valid}
+}
+
+// Int16From creates a new Int16 that will be null if zero.
+func Int16From(i int16) Int16 {
+	return NewInt16(i, i != 0)
+}
+
+// Int16FromPtr creates a new Int16 that be null if i is nil.
+func Int16FromPtr(i *int16) Int16 {
+	if i == nil {
+		return NewInt16(0, false)
+	}
+	return NewInt16(*i, *i != 0)
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Int16) Scan(value interface{}) error {
+	if value == nil {
+		i.Int16, i.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	i.Int16, i.Valid = int16(n.Int64), n.Valid && n.Int64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Int16) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Int16), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Int16.
+func (i *Int16) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		i.Int16 = int16(x)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Int16", v)
+	}
+	i.Valid = i.Int16 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int16 if the input is blank or zero.
+func (i *Int16) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseInt(str, 10, 16)
+	if err != nil {
+		return err
+	}
+	i.Int16 = int16(v)
+	i.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int16 is null.
+func (i Int16) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int16 is null.
+func (i Int16) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+}
+
+// SetValid changes this Int16's value and also sets it to be non-null.
+func (i *Int16) SetValid(n int16) {
+	i.Int16 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int16's value, or a nil pointer if this Int16 is null.
+func (i Int16) Ptr() *int16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int16
+}
+
+// IsZero returns true for null or zero Int16s, for future omitempty support.
+func (i Int16) IsZero() bool {
+	return !i.Valid || i.Int16 == 0
+}
+
+// OverwriteWithIfValid sets this Int16's value to n if v is true.
+func (i *Int16) OverwriteWithIfValid(n int16, v bool) {
+	if v {
+		i.Int16 = n
+		i.Valid = v
+	}
+}
+
+// Int32 is a nullable int32. Zero input is considered null.
+type Int32 struct {
+	Int32 int32
+	Valid bool
+}
+
+// NewInt32 creates a new Int32
+func NewInt32(i int32, valid bool) Int32 {
+	return Int32{Int32: i, Valid: valid}
+}
+
+// Int32From creates a new Int32 that will be null if zero.
+func Int32From(i int32) Int32 {
+	return NewInt32(i, i != 0)
+}
+
+// Int32FromPtr creates a new Int32 that be null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	if i == nil {
+		return NewInt32(0, false)
+	}
+	return NewInt32(*i, *i != 0)
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Int32) Scan(value interface{}) error {
+	if value == nil {
+		i.Int32, i.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	i.Int32, i.Valid = int32(n.Int64), n.Valid && n.Int64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (i Int32) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return int64(i.Int32), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Int32.
+func (i *Int32) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		i.Int32 = int32(x)
+	case nil:
+		i.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Int32", v)
+	}
+	i.Valid = i.Int32 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int32 if the input is blank or zero.
+func (i *Int32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		i.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseInt(str, 10, 32)
+	if err != nil {
+		return err
+	}
+	i.Int32 = int32(v)
+	i.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int32 is null.
+func (i Int32) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int32 is null.
+func (i Int32) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int32), 10)), nil
+}
+
+// SetValid changes this Int32's value and also sets it to be non-null.
+func (i *Int32) SetValid(n int32) {
+	i.Int32 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int32's value, or a nil pointer if this Int32 is null.
+func (i Int32) Ptr() *int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int32
+}
+
+// IsZero returns true for null or zero Int32s, for future omitempty support.
+func (i Int32) IsZero() bool {
+	return !i.Valid || i.Int32 == 0
+}
+
+// OverwriteWithIfValid sets this Int32's value to n if v is true.
+func (i *Int32) OverwriteWithIfValid(n int32, v bool) {
+	if v {
+		i.Int32 = n
+		i.Valid = v
+	}
+}
+
+// Int64 is an alias of Int, provided so the sized Int8/16/32/64 family is
+// complete. It is a nullable int64 where zero input is considered null.
+type Int64 = Int
+
+// NewInt64 creates a new Int64 (Int).
+func NewInt64(i int64, valid bool) Int64 {
+	return NewInt(i, valid)
+}
+
+// Int64From creates a new Int64 that will be null if zero.
+func Int64From(i int64) Int64 {
+	return IntFrom(i)
+}
+
+// Int64FromPtr creates a new Int64 that be null if i is nil.
+func Int64FromPtr(i *int64) Int64 {
+	return IntFromPtr(i)
+}
@@ -0,0 +1,130 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestIntJSON(t *testing.T) {
+	var i Int
+	if err := json.Unmarshal([]byte("0"), &i); err != nil {
+		t.Fatal(err)
+	}
+	if i.Valid {
+		t.Error("expected 0 to unmarshal as null")
+	}
+
+	if err := json.Unmarshal([]byte("42"), &i); err != nil {
+		t.Fatal(err)
+	}
+	if !i.Valid || i.Int64 != 42 {
+		t.Errorf("got %+v, want valid 42", i)
+	}
+
+	b, err := json.Marshal(IntFrom(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "0" {
+		t.Errorf("MarshalJSON() = %s, want 0", b)
+	}
+}
+
+func TestUintZero(t *testing.T) {
+	u := UintFrom(0)
+	if u.Valid {
+		t.Error("expected UintFrom(0) to be invalid")
+	}
+	u = UintFrom(7)
+	if !u.Valid || u.Uint != 7 {
+		t.Errorf("got %+v, want valid 7", u)
+	}
+}
+
+func TestFloat64JSON(t *testing.T) {
+	var f Float64
+	if err := json.Unmarshal([]byte("0"), &f); err != nil {
+		t.Fatal(err)
+	}
+	if f.Valid {
+		t.Error("expected 0 to unmarshal as null")
+	}
+	if err := json.Unmarshal([]byte("3.5"), &f); err != nil {
+		t.Fatal(err)
+	}
+	if !f.Valid || f.Float64 != 3.5 {
+		t.Errorf("got %+v, want valid 3.5", f)
+	}
+}
+
+func TestStringJSON(t *testing.T) {
+	var s String
+	if err := json.Unmarshal([]byte(`""`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Valid {
+		t.Error("expected empty string to unmarshal as null")
+	}
+	if err := json.Unmarshal([]byte(`"hi"`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if !s.Valid || s.String != "hi" {
+		t.Errorf("got %+v, want valid \"hi\"", s)
+	}
+}
+
+func TestBytesRoundTrip(t *testing.T) {
+	b := BytesFrom([]byte("payload"))
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out Bytes
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Equal(b) {
+		t.Errorf("got %+v, want %+v", out, b)
+	}
+
+	empty := BytesFrom(nil)
+	if empty.Valid {
+		t.Error("expected nil bytes to be invalid")
+	}
+}
+
+func TestJSONScanValidation(t *testing.T) {
+	var j JSON
+	if err := j.Scan([]byte(`{"a":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	if !j.Valid {
+		t.Error("expected valid JSON payload to scan as valid")
+	}
+
+	var bad JSON
+	if err := bad.Scan([]byte(`not json`)); err == nil {
+		t.Error("expected malformed JSON to return an error")
+	}
+
+	var n JSON
+	if err := n.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if n.Valid {
+		t.Error("expected nil to scan as invalid")
+	}
+}
+
+func TestTimeZero(t *testing.T) {
+	tm := TimeFrom(time.Time{})
+	if tm.Valid {
+		t.Error("expected zero time.Time to be invalid")
+	}
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tm = TimeFrom(now)
+	if !tm.Valid || !tm.Time.Equal(now) {
+		t.Errorf("got %+v, want valid %v", tm, now)
+	}
+}
@@ -0,0 +1,116 @@
+package zero
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// String is a nullable string. Blank string input is considered null.
+// JSON marshals to a blank string if null.
+// Considered null to SQL unmarshaled from a blank string.
+type String struct {
+	sql.NullString
+}
+
+// NewString creates a new String
+func NewString(s string, valid bool) String {
+	return String{
+		NullString: sql.NullString{
+			String: s,
+			Valid:  valid,
+		},
+	}
+}
+
+// StringFrom creates a new String that will be null if blank.
+func StringFrom(s string) String {
+	return NewString(s, s != "")
+}
+
+// StringFromPtr creates a new String that be null if s is nil.
+func StringFromPtr(s *string) String {
+	if s == nil {
+		return NewString("", false)
+	}
+	return NewString(*s, *s != "")
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "" will be considered a null String.
+func (s *String) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case string:
+		s.String = x
+	case nil:
+		s.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.String", v)
+	}
+	s.Valid = s.String != ""
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null String if the input is blank.
+func (s *String) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "null" {
+		s.Valid = false
+		return nil
+	}
+	s.String = str
+	s.Valid = str != ""
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "" if this String is null.
+func (s String) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(s.String)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this String is null.
+func (s String) MarshalText() ([]byte, error) {
+	if !s.Valid {
+		return []byte{}, nil
+	}
+	return []byte(s.String), nil
+}
+
+// SetValid changes this String's value and also sets it to be non-null.
+func (s *String) SetValid(v string) {
+	s.String = v
+	s.Valid = true
+}
+
+// Ptr returns a pointer to this String's value, or a nil pointer if this String is null.
+func (s String) Ptr() *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// IsZero returns true for null or blank Strings, for future omitempty support.
+func (s String) IsZero() bool {
+	return !s.Valid || s.String == ""
+}
+
+// OverwriteWithIfValid sets this String's value to v if valid is true. Used
+// for type conversion from sql.NullString to zero.
+func (s *String) OverwriteWithIfValid(v string, valid bool) {
+	if valid {
+		s.String = v
+		s.Valid = valid
+	}
+}
@@ -0,0 +1,121 @@
+package zero
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Time is a nullable time.Time. A zero time.Time is considered null.
+// JSON marshals to the zero time if null.
+// Considered null to SQL unmarshaled from a zero-value time.
+type Time struct {
+	sql.NullTime
+}
+
+// NewTime creates a new Time
+func NewTime(t time.Time, valid bool) Time {
+	return Time{
+		NullTime: sql.NullTime{
+			Time:  t,
+			Valid: valid,
+		},
+	}
+}
+
+// TimeFrom creates a new Time that will be null if t is the zero time.Time.
+func TimeFrom(t time.Time) Time {
+	return NewTime(t, !t.IsZero())
+}
+
+// TimeFromPtr creates a new Time that be null if t is nil.
+func TimeFromPtr(t *time.Time) Time {
+	if t == nil {
+		return NewTime(time.Time{}, false)
+	}
+	return TimeFrom(*t)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// The zero time will be considered a null Time.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch v.(type) {
+	case string:
+		if err := t.Time.UnmarshalJSON(data); err != nil {
+			return err
+		}
+	case nil:
+		t.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Time", v)
+	}
+	t.Valid = !t.Time.IsZero()
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Time if the input is blank or the zero time.
+func (t *Time) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		t.Valid = false
+		return nil
+	}
+	if err := t.Time.UnmarshalText(text); err != nil {
+		return err
+	}
+	t.Valid = !t.Time.IsZero()
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the zero time if this Time is null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return time.Time{}.MarshalJSON()
+	}
+	return t.Time.MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the zero time if this Time is null.
+func (t Time) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return time.Time{}.MarshalText()
+	}
+	return t.Time.MarshalText()
+}
+
+// SetValid changes this Time's value and also sets it to be non-null.
+func (t *Time) SetValid(v time.Time) {
+	t.Time = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this Time's value, or a nil pointer if this Time is null.
+func (t Time) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// IsZero returns true for null or zero-value Times, for future omitempty support.
+func (t Time) IsZero() bool {
+	return !t.Valid || t.Time.IsZero()
+}
+
+// OverwriteWithIfValid sets this Time's value to v if valid is true. Used
+// for type conversion from sql.NullTime to zero.
+func (t *Time) OverwriteWithIfValid(v time.Time, valid bool) {
+	if valid {
+		t.Time = v
+		t.Valid = valid
+	}
+}
@@ -0,0 +1,542 @@
+package zero
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Uint is a nullable uint64. Zero input is considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL unmarshaled from a zero value.
+type Uint struct {
+	Uint  uint64
+	Valid bool
+}
+
+// NewUint creates a new Uint
+func NewUint(u uint64, valid bool) Uint {
+	return Uint{Uint: u, Valid: valid}
+}
+
+// UintFrom creates a new Uint that will be null if zero.
+func UintFrom(u uint64) Uint {
+	return NewUint(u, u != 0)
+}
+
+// UintFromPtr creates a new Uint that be null if u is nil.
+func UintFromPtr(u *uint64) Uint {
+	if u == nil {
+		return NewUint(0, false)
+	}
+	return NewUint(*u, *u != 0)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Uint) Scan(value interface{}) error {
+	if value == nil {
+		u.Uint, u.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	u.Uint, u.Valid = uint64(n.Int64), n.Valid && n.Int64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (u Uint) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Uint.
+func (u *Uint) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		u.Uint = uint64(x)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Uint", v)
+	}
+	u.Valid = u.Uint != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint if the input is blank or zero.
+func (u *Uint) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		u.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return err
+	}
+	u.Uint = v
+	u.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint is null.
+func (u Uint) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatUint(u.Uint, 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint is null.
+func (u Uint) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatUint(u.Uint, 10)), nil
+}
+
+// SetValid changes this Uint's value and also sets it to be non-null.
+func (u *Uint) SetValid(n uint64) {
+	u.Uint = n
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
+func (u Uint) Ptr() *uint64 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint
+}
+
+// IsZero returns true for null or zero Uints, for future omitempty support.
+func (u Uint) IsZero() bool {
+	return !u.Valid || u.Uint == 0
+}
+
+// OverwriteWithIfValid sets this Uint's value to n if v is true.
+func (u *Uint) OverwriteWithIfValid(n uint64, v bool) {
+	if v {
+		u.Uint = n
+		u.Valid = v
+	}
+}
+
+// Uint8 is a nullable uint8. Zero input is considered null.
+type Uint8 struct {
+	Uint8 uint8
+	Valid bool
+}
+
+// NewUint8 creates a new Uint8
+func NewUint8(u uint8, valid bool) Uint8 {
+	return Uint8{Uint8: u, Valid: valid}
+}
+
+// Uint8From creates a new Uint8 that will be null if zero.
+func Uint8From(u uint8) Uint8 {
+	return NewUint8(u, u != 0)
+}
+
+// Uint8FromPtr creates a new Uint8 that be null if u is nil.
+func Uint8FromPtr(u *uint8) Uint8 {
+	if u == nil {
+		return NewUint8(0, false)
+	}
+	return NewUint8(*u, *u != 0)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Uint8) Scan(value interface{}) error {
+	if value == nil {
+		u.Uint8, u.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	u.Uint8, u.Valid = uint8(n.Int64), n.Valid && n.Int64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (u Uint8) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint8), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Uint8.
+func (u *Uint8) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		u.Uint8 = uint8(x)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Uint8", v)
+	}
+	u.Valid = u.Uint8 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint8 if the input is blank or zero.
+func (u *Uint8) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		u.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseUint(str, 10, 8)
+	if err != nil {
+		return err
+	}
+	u.Uint8 = uint8(v)
+	u.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint8 is null.
+func (u Uint8) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint8), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint8 is null.
+func (u Uint8) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint8), 10)), nil
+}
+
+// SetValid changes this Uint8's value and also sets it to be non-null.
+func (u *Uint8) SetValid(n uint8) {
+	u.Uint8 = n
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
+func (u Uint8) Ptr() *uint8 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint8
+}
+
+// IsZero returns true for null or zero Uint8s, for future omitempty support.
+func (u Uint8) IsZero() bool {
+	return !u.Valid || u.Uint8 == 0
+}
+
+// OverwriteWithIfValid sets this Uint8's value to n if v is true.
+func (u *Uint8) OverwriteWithIfValid(n uint8, v bool) {
+	if v {
+		u.Uint8 = n
+		u.Valid = v
+	}
+}
+
+// Uint16 is a nullable uint16. Zero input is considered null.
+type Uint16 struct {
+	Uint16 uint16
+	Valid  bool
+}
+
+// NewUint16 creates a new Uint16
+func NewUint16(u uint16, valid bool) Uint16 {
+	return Uint16{Uint16: u, Valid: valid}
+}
+
+// Uint16From creates a new Uint16 that will be null if zero.
+func Uint16From(u uint16) Uint16 {
+	return NewUint16(u, u != 0)
+}
+
+// Uint16FromPtr creates a new Uint16 that be null if u is nil.
+func Uint16FromPtr(u *uint16) Uint16 {
+	if u == nil {
+		return NewUint16(0, false)
+	}
+	return NewUint16(*u, *u != 0)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Uint16) Scan(value interface{}) error {
+	if value == nil {
+		u.Uint16, u.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	u.Uint16, u.Valid = uint16(n.Int64), n.Valid && n.Int64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (u Uint16) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint16), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Uint16.
+func (u *Uint16) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		u.Uint16 = uint16(x)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Uint16", v)
+	}
+	u.Valid = u.Uint16 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint16 if the input is blank or zero.
+func (u *Uint16) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		u.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseUint(str, 10, 16)
+	if err != nil {
+		return err
+	}
+	u.Uint16 = uint16(v)
+	u.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint16 is null.
+func (u Uint16) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint16), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint16 is null.
+func (u Uint16) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint16), 10)), nil
+}
+
+// SetValid changes this Uint16's value and also sets it to be non-null.
+func (u *Uint16) SetValid(n uint16) {
+	u.Uint16 = n
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
+func (u Uint16) Ptr() *uint16 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint16
+}
+
+// IsZero returns true for null or zero Uint16s, for future omitempty support.
+func (u Uint16) IsZero() bool {
+	return !u.Valid || u.Uint16 == 0
+}
+
+// OverwriteWithIfValid sets this Uint16's value to n if v is true.
+func (u *Uint16) OverwriteWithIfValid(n uint16, v bool) {
+	if v {
+		u.Uint16 = n
+		u.Valid = v
+	}
+}
+
+// Uint32 is a nullable uint32. Zero input is considered null.
+type Uint32 struct {
+	Uint32 uint32
+	Valid  bool
+}
+
+// NewUint32 creates a new Uint32
+func NewUint32(u uint32, valid bool) Uint32 {
+	return Uint32{Uint32: u, Valid: valid}
+}
+
+// Uint32From creates a new Uint32 that will be null if zero.
+func Uint32From(u uint32) Uint32 {
+	return NewUint32(u, u != 0)
+}
+
+// Uint32FromPtr creates a new Uint32 that be null if u is nil.
+func Uint32FromPtr(u *uint32) Uint32 {
+	if u == nil {
+		return NewUint32(0, false)
+	}
+	return NewUint32(*u, *u != 0)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Uint32) Scan(value interface{}) error {
+	if value == nil {
+		u.Uint32, u.Valid = 0, false
+		return nil
+	}
+	var n sql.NullInt64
+	if err := n.Scan(value); err != nil {
+		return err
+	}
+	u.Uint32, u.Valid = uint32(n.Int64), n.Valid && n.Int64 != 0
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (u Uint32) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return int64(u.Uint32), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// "0" will be considered a null Uint32.
+func (u *Uint32) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case float64:
+		u.Uint32 = uint32(x)
+	case nil:
+		u.Valid = false
+		return nil
+	default:
+		return fmt.Errorf("json: cannot unmarshal %T into Go value of type zero.Uint32", v)
+	}
+	u.Valid = u.Uint32 != 0
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint32 if the input is blank or zero.
+func (u *Uint32) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		u.Valid = false
+		return nil
+	}
+	v, err := strconv.ParseUint(str, 10, 32)
+	if err != nil {
+		return err
+	}
+	u.Uint32 = uint32(v)
+	u.Valid = v != 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint32 is null.
+func (u Uint32) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint32), 10)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint32 is null.
+func (u Uint32) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte("0"), nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint32), 10)), nil
+}
+
+// SetValid changes this Uint32's value and also sets it to be non-null.
+func (u *Uint32) SetValid(n uint32) {
+	u.Uint32 = n
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
+func (u Uint32) Ptr() *uint32 {
+	if !u.Valid {
+		return nil
+	}
+	return &u.Uint32
+}
+
+// IsZero returns true for null or zero Uint32s, for future omitempty support.
+func (u Uint32) IsZero() bool {
+	return !u.Valid || u.Uint32 == 0
+}
+
+// OverwriteWithIfValid sets this Uint32's value to n if v is true.
+func (u *Uint32) OverwriteWithIfValid(n uint32, v bool) {
+	if v {
+		u.Uint32 = n
+		u.Valid = v
+	}
+}
+
+// Uint64 is an alias of Uint, provided so the sized Uint8/16/32/64 family is
+// complete. It is a nullable uint64 where zero input is considered null.
+type Uint64 = Uint
+
+// NewUint64 creates a new Uint64 (Uint).
+func NewUint64(u uint64, valid bool) Uint64 {
+	return NewUint(u, valid)
+}
+
+// Uint64From creates a new Uint64 that will be null if zero.
+func Uint64From(u uint64) Uint64 {
+	return UintFrom(u)
+}
+
+// Uint64FromPtr creates a new Uint64 that be null if u is nil.
+func Uint64FromPtr(u *uint64) Uint64 {
+	return UintFromPtr(u)
+}